@@ -60,10 +60,15 @@ func (period Period) simpleAdd(that Period) Period {
 		fpart = NoFraction
 	}
 
+	// weeks have no field of their own in the result; they are decomposed
+	// into days here, same as centiDays does for the other arithmetic
+	// paths.
+	days := period.days + that.days + (period.weeks+that.weeks)*7
+
 	return Period{
 		years:    period.years + that.years,
 		months:   period.months + that.months,
-		days:     period.days + that.days,
+		days:     days,
 		hours:    period.hours + that.hours,
 		minutes:  period.minutes + that.minutes,
 		seconds:  period.seconds + that.seconds,
@@ -105,11 +110,15 @@ func (period Period) nonTrivialAdd(that Period) Period {
 func (period Period) AddTo(t time.Time) (time.Time, bool) {
 	wholeYears := period.fpart != Year
 	wholeMonths := period.fpart != Month
+	wholeWeeks := period.fpart != Week
 	wholeDays := period.fpart != Day
 
-	if wholeYears && wholeMonths && wholeDays {
-		// in this case, time.AddDate provides an exact solution
-		t1 := t.AddDate(int(period.years), int(period.months), int(period.days))
+	if wholeYears && wholeMonths && wholeWeeks && wholeDays {
+		// in this case, time.AddDate provides an exact solution; weeks are
+		// decomposed into days here, same as centiDays does for the other
+		// arithmetic paths
+		days := int(period.days) + int(period.weeks)*7
+		t1 := t.AddDate(int(period.years), int(period.months), days)
 		return t1.Add(period.hmsDuration()), true
 	}
 
@@ -124,9 +133,9 @@ func (period Period) AddTo(t time.Time) (time.Time, bool) {
 // ignored.
 //
 // Bear in mind that the internal representation is limited by fixed-point arithmetic with two
-// decimal places; each field is only int16.
-//
-// Known issue: scaling by a large reduction factor (i.e. much less than one) doesn't work properly.
+// decimal places; each field is only int16. Internally, Scale routes through ScaleBig's
+// big.Rat arithmetic: factor is first written as a decimal with six places, the product is
+// computed exactly, and only the result is rounded to hundredths of a month, day or second.
 func (period Period) Scale(factor float32) Period {
 	result, _ := period.ScaleWithOverflowCheck(factor)
 	return result
@@ -144,10 +153,7 @@ func (period Period) ScaleWithOverflowCheck(factor float32) (Period, error) {
 	if !ok {
 		return Period{}, fmt.Errorf("unable to scale period %s using %f", period, factor)
 	}
-
-	multiplier64 := bigRat.Num().Int64()
-	divisor64 := bigRat.Denom().Int64()
-	return period.rationalScale64(multiplier64, divisor64)
+	return period.ScaleBig(bigRat)
 }
 
 // RationalScale scales a period by a rational multiplication factor. Obviously, this can both enlarge and shrink it,
@@ -159,42 +165,5 @@ func (period Period) ScaleWithOverflowCheck(factor float32) (Period, error) {
 // Bear in mind that the internal representation is limited by fixed-point arithmetic with two
 // decimal places; each field is only int16.
 func (period Period) RationalScale(multiplier, divisor int) (Period, error) {
-	return period.rationalScale64(int64(multiplier), int64(divisor))
-}
-
-func (period Period) rationalScale64(m, d int64) (Period, error) {
-	ap, neg := period.absNeg()
-
-	cym := ap.centiYM()
-	cd := ap.centiDays()
-	chms := ap.centiHMS()
-
-	mcym := cym * m
-	mcd := cd * m
-	mchms := chms * m
-
-	cymr := mcym % d
-	cdr := mcd % d
-	chmsr := mchms % d
-
-	if cymr == 0 && cdr == 0 && chmsr == 0 {
-		// special case: scaled result is integral
-		scd := mcd / d
-		if d > m && scd*d != mcd {
-			mchms = mcd * 24
-			mcd = 0
-		}
-		return p64Of(mcym/d, scd, mchms/d, neg).toPeriod()
-	}
-
-	// fall back on reliable but approximate algorithm
-	ymdDuration := time.Duration(cym*daysPerMonthE6+cd*oneE6) * 864 * time.Microsecond
-	hmsDuration := time.Duration(chms) * 10 * time.Millisecond
-	duration := ymdDuration + hmsDuration
-	pr1 := ymdDuration == 0
-	mul := (int64(duration) * m) / d
-	// add 5ms to round half-up
-	p2, pr2 := NewOf(time.Duration(mul) + 5*time.Millisecond)
-	precise := pr1 && pr2
-	return p2.condNegate(neg).Normalise(precise).Simplify(precise), nil
+	return period.ScaleBig(big.NewRat(int64(multiplier), int64(divisor)))
 }
@@ -0,0 +1,107 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import "time"
+
+// Between returns the calendar-aware Period between two instants, as
+// observed using t1's location. It is the natural inverse of AddTo: for any
+// t and y/m/d/h/m/s already in normal form (months below 12, days short of
+// the month reached, hours below 24, minutes and seconds below 60),
+// Between(t, t.AddDate(y, m, d).Add(hms)) equals the Period built from those
+// same fields. Fields outside that form come back normalised, e.g. 31 days
+// from the 1st of January is returned as one month.
+//
+// Unlike NewOf, which only knows about a plain time.Duration and so can
+// only ever produce hours, minutes and seconds, Between walks the year,
+// month and day boundaries the way time.AddDate does, borrowing from the
+// actual length of the intervening months (and so handling leap days and
+// DST transitions correctly).
+func Between(t1, t2 time.Time) Period {
+	return In(t1.Location(), t1, t2)
+}
+
+// In is Between, but evaluates both instants in the supplied location first
+// so that civil boundaries (the start of a month, a DST transition) are
+// measured there rather than in t1's own location.
+func In(loc *time.Location, t1, t2 time.Time) Period {
+	t1 = t1.In(loc)
+	t2 = t2.In(loc)
+
+	neg := t2.Before(t1)
+	if neg {
+		t1, t2 = t2, t1
+	}
+
+	y1, m1, d1 := t1.Date()
+	y2, m2, d2 := t2.Date()
+
+	years := y2 - y1
+	months := int(m2) - int(m1)
+	days := d2 - d1
+
+	// borrowDay borrows one month and adds it to days, using the actual
+	// length (28-31 days) of the month immediately before the current
+	// (years, months) target, exactly as time.AddDate's inverse requires.
+	borrowDay := func() {
+		// day 0 of a month normalises to the last day of the month before it
+		prevMonthEnd := time.Date(y1+years, m1+time.Month(months), 0, 0, 0, 0, 0, loc)
+		days += prevMonthEnd.Day()
+		months--
+		if months < 0 {
+			months += 12
+			years--
+		}
+	}
+
+	if days < 0 {
+		borrowDay()
+	}
+
+	// t1.AddDate(years, months, days) may not land exactly on t2's
+	// time-of-day across a DST transition, so the hours/minutes/seconds
+	// are always taken from the residual duration rather than from the
+	// wall-clock fields directly; this keeps Between the exact inverse of
+	// AddTo.
+	anchor := t1.AddDate(years, months, days)
+	residual := t2.Sub(anchor)
+	if residual < 0 {
+		// The day-of-month arithmetic above landed on the right civil date,
+		// but t2's time-of-day is earlier than t1's, so one more day must
+		// be borrowed (mirroring the day-of-month borrow above).
+		days--
+		if days < 0 {
+			borrowDay()
+		}
+		anchor = t1.AddDate(years, months, days)
+		residual = t2.Sub(anchor)
+	}
+
+	hours := int(residual / time.Hour)
+	residual -= time.Duration(hours) * time.Hour
+	minutes := int(residual / time.Minute)
+	residual -= time.Duration(minutes) * time.Minute
+	seconds := int(residual / time.Second)
+	residual -= time.Duration(seconds) * time.Second
+
+	p := Period{
+		years:   int16(years),
+		months:  int16(months),
+		days:    int16(days),
+		hours:   int16(hours),
+		minutes: int16(minutes),
+		seconds: int16(seconds),
+	}
+
+	if residual != 0 {
+		p.fraction = int8((residual * 100) / time.Second)
+		p.fpart = Second
+	}
+
+	if neg {
+		p = p.Negate()
+	}
+	return p
+}
@@ -0,0 +1,91 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBetweenBorrowsForTimeOfDayUnderflow(t *testing.T) {
+	t1 := time.Date(2021, time.June, 15, 8, 0, 0, 0, time.UTC)
+	t2 := time.Date(2021, time.June, 20, 3, 0, 0, 0, time.UTC)
+
+	p := Between(t1, t2)
+
+	if p.days < 0 || p.hours < 0 {
+		t.Fatalf("Between produced mixed-sign fields: days=%d hours=%d", p.days, p.hours)
+	}
+	if p.days != 4 || p.hours != 19 {
+		t.Errorf("Between(%v, %v) = {days:%d hours:%d}, want {days:4 hours:19}", t1, t2, p.days, p.hours)
+	}
+}
+
+func TestBetweenReversedOrder(t *testing.T) {
+	t1 := time.Date(2021, time.June, 20, 3, 0, 0, 0, time.UTC)
+	t2 := time.Date(2021, time.June, 15, 8, 0, 0, 0, time.UTC)
+
+	p := Between(t1, t2)
+
+	if !p.IsNegative() {
+		t.Fatalf("Between(%v, %v) = %v, want a negative period since t2 precedes t1", t1, t2, p)
+	}
+	if p.days > 0 || p.hours > 0 {
+		t.Fatalf("Between produced mixed-sign fields: days=%d hours=%d", p.days, p.hours)
+	}
+	if p.days != -4 || p.hours != -19 {
+		t.Errorf("Between(%v, %v) = {days:%d hours:%d}, want {days:-4 hours:-19}", t1, t2, p.days, p.hours)
+	}
+}
+
+func TestBetweenAcrossDSTTransition(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("America/New_York tzdata unavailable: %v", err)
+	}
+
+	// clocks spring forward 1h at 2024-03-10 02:00 America/New_York
+	t1 := time.Date(2024, time.March, 9, 12, 0, 0, 0, loc)
+	t2 := time.Date(2024, time.March, 11, 12, 0, 0, 0, loc)
+
+	p := In(loc, t1, t2)
+
+	// the civil dates are exactly 2 days apart regardless of the DST jump;
+	// In measures civil fields in loc, so the result is not polluted by
+	// the lost hour the way a plain t2.Sub(t1) would be.
+	if p.years != 0 || p.months != 0 || p.days != 2 || p.hours != 0 || p.minutes != 0 || p.seconds != 0 {
+		t.Errorf("In(%v, %v, %v) = %+v, want exactly 2 days", loc, t1, t2, p)
+	}
+
+	back, precise := p.AddTo(t1)
+	if !precise {
+		t.Fatalf("AddTo of a whole-days period should be precise")
+	}
+	if !back.Equal(t2) {
+		t.Errorf("Between is not the inverse of AddTo across a DST transition: got %v, want %v", back, t2)
+	}
+}
+
+func TestBetweenInvariant(t *testing.T) {
+	cases := []struct {
+		t                   time.Time
+		y, m, d, h, mi, sec int
+	}{
+		{time.Date(2020, time.January, 31, 8, 0, 0, 0, time.UTC), 0, 1, 2, 0, 0, 0},
+		{time.Date(2021, time.June, 15, 8, 0, 0, 0, time.UTC), 0, 0, 5, 19, 0, 0},
+		{time.Date(2020, time.February, 28, 10, 0, 0, 0, time.UTC), 0, 0, 1, 2, 0, 0}, // leap day
+	}
+	for _, c := range cases {
+		hms := time.Duration(c.h)*time.Hour + time.Duration(c.mi)*time.Minute + time.Duration(c.sec)*time.Second
+		t2 := c.t.AddDate(c.y, c.m, c.d).Add(hms)
+
+		p := Between(c.t, t2)
+		if int(p.years) != c.y || int(p.months) != c.m || int(p.days) != c.d ||
+			int(p.hours) != c.h || int(p.minutes) != c.mi || int(p.seconds) != c.sec {
+			t.Errorf("Between(%v, %v) = {y:%d m:%d d:%d h:%d min:%d s:%d}, want {y:%d m:%d d:%d h:%d min:%d s:%d}",
+				c.t, t2, p.years, p.months, p.days, p.hours, p.minutes, p.seconds, c.y, c.m, c.d, c.h, c.mi, c.sec)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// bigPeriod is an arbitrary-precision intermediate representation of a
+// Period, mirroring the three centi-fixed-point quantities (centiYM,
+// centiDays, centiHMS) that p64Of takes, but backed by big.Rat so that
+// scaling by a small factor never loses precision to int64 fixed-point
+// rounding. All three fields are always non-negative; neg carries the sign,
+// exactly as period64 does.
+type bigPeriod struct {
+	months, days, seconds *big.Rat
+	neg                   bool
+}
+
+// toBig converts the period to its high-precision intermediate form.
+func (period Period) toBig() bigPeriod {
+	cym := period.centiYM()
+	cd := period.centiDays()
+	chms := period.centiHMS()
+	neg := period.IsNegative()
+	if neg {
+		cym, cd, chms = -cym, -cd, -chms
+	}
+	return bigPeriod{
+		months:  big.NewRat(cym, 100),
+		days:    big.NewRat(cd, 100),
+		seconds: big.NewRat(chms, 100),
+		neg:     neg,
+	}
+}
+
+// toPeriod converts back to the compact int16-based Period, returning an
+// error if any field overflows int16 once rounded back to the two-decimal
+// centi-fixed-point representation that Period uses.
+func (bp bigPeriod) toPeriod() (Period, error) {
+	cym, ok := ratToCenti(bp.months)
+	if !ok {
+		return Period{}, fmt.Errorf("period: overflow converting months %s back to a Period", bp.months.RatString())
+	}
+	cd, ok := ratToCenti(bp.days)
+	if !ok {
+		return Period{}, fmt.Errorf("period: overflow converting days %s back to a Period", bp.days.RatString())
+	}
+	chms, ok := ratToCenti(bp.seconds)
+	if !ok {
+		return Period{}, fmt.Errorf("period: overflow converting seconds %s back to a Period", bp.seconds.RatString())
+	}
+	return p64Of(cym, cd, chms, bp.neg).toPeriod()
+}
+
+// ratToCenti rounds r*100 to the nearest integer and reports false if the
+// result doesn't fit in an int64.
+func ratToCenti(r *big.Rat) (int64, bool) {
+	scaled := new(big.Rat).Mul(r, big.NewRat(100, 1))
+	num := new(big.Int).Set(scaled.Num())
+	denom := scaled.Denom()
+	half := new(big.Int).Rsh(denom, 1)
+	num.Add(num, half)
+	q := new(big.Int).Quo(num, denom)
+	if !q.IsInt64() {
+		return 0, false
+	}
+	return q.Int64(), true
+}
+
+// ScaleBig scales a period by an arbitrary-precision rational factor,
+// routing the computation through bigPeriod so that the product for a small
+// factor (e.g. 1/1000) is exact before the result is rounded back to
+// Period's two decimal places. Scale, ScaleWithOverflowCheck and
+// RationalScale all delegate to it.
+func (period Period) ScaleBig(factor *big.Rat) (Period, error) {
+	bp := period.toBig()
+	neg := bp.neg
+	if factor.Sign() < 0 {
+		neg = !neg
+	}
+	abs := new(big.Rat).Abs(factor)
+	bp.months = new(big.Rat).Mul(bp.months, abs)
+	bp.days = new(big.Rat).Mul(bp.days, abs)
+	bp.seconds = new(big.Rat).Mul(bp.seconds, abs)
+	bp.neg = neg
+
+	p, err := bp.toPeriod()
+	if err != nil {
+		return Period{}, fmt.Errorf("%s: cannot scale by %s: %w", period, factor.RatString(), err)
+	}
+	return p, nil
+}
+
+// AddBig adds amount, expressed in units of the given designator (Year,
+// Month, Day, Hour, Minute or Second), to the period using exact rational
+// arithmetic, and reports an overflow error rather than silently wrapping.
+func (period Period) AddBig(amount *big.Rat, unit designator) (Period, error) {
+	bp := period.toBig()
+	months, days, seconds := bp.signed()
+
+	scaled := func(perUnit int64) *big.Rat {
+		return new(big.Rat).Mul(amount, big.NewRat(perUnit, 1))
+	}
+
+	switch unit {
+	case Year:
+		months.Add(months, scaled(12))
+	case Month:
+		months.Add(months, amount)
+	case Day:
+		days.Add(days, amount)
+	case Hour:
+		seconds.Add(seconds, scaled(3600))
+	case Minute:
+		seconds.Add(seconds, scaled(60))
+	case Second:
+		seconds.Add(seconds, amount)
+	default:
+		return Period{}, fmt.Errorf("period: AddBig: unsupported designator %v", unit)
+	}
+
+	return bigPeriodFromSigned(months, days, seconds).toPeriod()
+}
+
+// signed returns the three fields as signed rationals (i.e. with neg folded
+// in), for use when combining them with another signed quantity such as the
+// amount passed to AddBig.
+func (bp bigPeriod) signed() (months, days, seconds *big.Rat) {
+	months = new(big.Rat).Set(bp.months)
+	days = new(big.Rat).Set(bp.days)
+	seconds = new(big.Rat).Set(bp.seconds)
+	if bp.neg {
+		months.Neg(months)
+		days.Neg(days)
+		seconds.Neg(seconds)
+	}
+	return months, days, seconds
+}
+
+// bigPeriodFromSigned is the inverse of signed: it takes three signed
+// rationals and re-derives the non-negative fields plus sign that bigPeriod
+// requires, assuming the overall sign is given by whichever field is
+// non-zero (they are expected to agree, as period64's own fields do).
+func bigPeriodFromSigned(months, days, seconds *big.Rat) bigPeriod {
+	neg := months.Sign() < 0 || days.Sign() < 0 || seconds.Sign() < 0
+	if neg {
+		months = new(big.Rat).Neg(months)
+		days = new(big.Rat).Neg(days)
+		seconds = new(big.Rat).Neg(seconds)
+	}
+	return bigPeriod{months: months, days: days, seconds: seconds, neg: neg}
+}
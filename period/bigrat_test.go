@@ -0,0 +1,68 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestScaleDelegatesToScaleBig(t *testing.T) {
+	p := MustParse("P1Y")
+
+	viaScale := p.Scale(0.001)
+	viaBig, err := p.ScaleBig(big.NewRat(1, 1000))
+	if err != nil {
+		t.Fatalf("ScaleBig failed: %v", err)
+	}
+
+	if viaScale != viaBig {
+		t.Errorf("Scale(0.001) = %+v, want the same result as ScaleBig(1/1000) = %+v", viaScale, viaBig)
+	}
+	if viaScale.IsZero() {
+		t.Errorf("Scale(0.001) on P1Y collapsed to zero; a small fraction should have survived")
+	}
+}
+
+func TestRationalScaleDelegatesToScaleBig(t *testing.T) {
+	p := MustParse("P1Y")
+
+	viaRational, err := p.RationalScale(1, 1000)
+	if err != nil {
+		t.Fatalf("RationalScale failed: %v", err)
+	}
+	viaBig, err := p.ScaleBig(big.NewRat(1, 1000))
+	if err != nil {
+		t.Fatalf("ScaleBig failed: %v", err)
+	}
+
+	if viaRational != viaBig {
+		t.Errorf("RationalScale(1, 1000) = %+v, want %+v", viaRational, viaBig)
+	}
+}
+
+func TestScaleBigNegativeFactor(t *testing.T) {
+	p := MustParse("P2D")
+	got, err := p.ScaleBig(big.NewRat(-1, 2))
+	if err != nil {
+		t.Fatalf("ScaleBig failed: %v", err)
+	}
+	want := MustParse("-P1D")
+	if got != want {
+		t.Errorf("ScaleBig(-1/2) on P2D = %+v, want %+v", got, want)
+	}
+}
+
+func TestAddBig(t *testing.T) {
+	p := MustParse("P1D")
+	got, err := p.AddBig(big.NewRat(1, 2), Day)
+	if err != nil {
+		t.Fatalf("AddBig failed: %v", err)
+	}
+	want := MustParse("P1.5D")
+	if got != want {
+		t.Errorf("AddBig(1/2, Day) on P1D = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import (
+	"time"
+
+	"github.com/simplylizz/date"
+)
+
+// Calendar describes which days are non-working, for use with
+// AddToBusiness and BusinessDuration.
+type Calendar struct {
+	// Weekend flags the non-working days of the week, indexed by
+	// time.Weekday.
+	Weekend [7]bool
+	// Holidays lists additional non-working calendar dates, on top of the
+	// weekend.
+	Holidays map[date.Date]bool
+	// WorkdayLength is the length of a working day, used to apply a
+	// fractional days component and by BusinessDuration. It defaults to
+	// 8 hours when zero.
+	WorkdayLength time.Duration
+}
+
+// workdayLength returns cal.WorkdayLength, defaulting to 8 hours.
+func (cal Calendar) workdayLength() time.Duration {
+	if cal.WorkdayLength > 0 {
+		return cal.WorkdayLength
+	}
+	return 8 * time.Hour
+}
+
+// isNonWorking reports whether t falls on a weekend or holiday.
+func (cal Calendar) isNonWorking(t time.Time) bool {
+	if cal.Weekend[t.Weekday()] {
+		return true
+	}
+	return cal.Holidays != nil && cal.Holidays[date.NewAt(t)]
+}
+
+// AddToBusiness is as per AddTo, except that the days component (and its
+// fraction) is applied one working day at a time, skipping cal's weekends
+// and holidays; "P5D" applied to a Wednesday therefore lands on the next
+// working Wednesday rather than on the calendar Monday. Weeks count as
+// seven days each, as in AddTo. Whole years and months are applied with
+// time.AddDate, as AddTo does, and any remaining fraction of a month is
+// approximated using the average month length; hours, minutes and seconds
+// are applied as in AddTo. A fractional day is applied as that fraction of
+// cal's working day length.
+func (period Period) AddToBusiness(t time.Time, cal Calendar) (time.Time, bool) {
+	precise := true
+
+	cym := period.centiYM()
+	t1 := t.AddDate(0, int(cym/100), 0)
+	if f := cym % 100; f != 0 {
+		t1 = t1.Add(time.Duration(float64(f) / 100 * hoursPerMonthF * float64(time.Hour)))
+		precise = false
+	}
+
+	cd := period.centiDays()
+	days := int(cd / 100)
+	step := 1
+	if period.IsNegative() {
+		step, days = -1, -days
+	}
+
+	for i := 0; i < days; i++ {
+		t1 = t1.AddDate(0, 0, step)
+		for cal.isNonWorking(t1) {
+			t1 = t1.AddDate(0, 0, step)
+		}
+	}
+
+	if f := cd % 100; f != 0 {
+		// the fraction already carries the period's sign (see
+		// period64.toPeriod), so no further sign adjustment is needed here.
+		frac := float64(f) / 100
+		t1 = t1.Add(time.Duration(frac * float64(cal.workdayLength())))
+		precise = false
+	}
+
+	t2 := t1.Add(period.hmsDuration())
+	return t2, precise
+}
+
+// BusinessDuration is an approximate Duration for the period, as per
+// Duration, except that the days component (with weeks counted as seven
+// days) is measured in cal's working day length (by default 8h) rather than
+// a flat 24h. It is useful for SLA and invoicing estimates expressed in
+// business days.
+func (period Period) BusinessDuration(cal Calendar) time.Duration {
+	months := float64(period.centiYM()) / 100
+	approxMonths := time.Duration(months * hoursPerMonthF * float64(time.Hour))
+
+	days := float64(period.centiDays()) / 100
+	businessDays := time.Duration(days * float64(cal.workdayLength()))
+
+	// years, months, days and the hms duration are all already signed
+	// consistently with the period itself, so no further sign flip is
+	// needed here.
+	return approxMonths + businessDays + period.hmsDuration()
+}
@@ -0,0 +1,79 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import (
+	"testing"
+	"time"
+
+	"github.com/simplylizz/date"
+)
+
+func TestAddToBusinessNegativeFraction(t *testing.T) {
+	cal := Calendar{}
+	p := MustParse("-P5.5D")
+
+	start := time.Date(2024, time.March, 13, 0, 0, 0, 0, time.UTC) // a Wednesday
+	got, precise := p.AddToBusiness(start, cal)
+	if precise {
+		t.Fatalf("expected an imprecise result for a fractional day")
+	}
+
+	// -5 whole days from 2024-03-13 (no weekend/holidays configured) lands
+	// on 2024-03-08; the trailing -0.5D then steps half a working day
+	// *further back*, not forward.
+	wantDate := time.Date(2024, time.March, 8, 0, 0, 0, 0, time.UTC)
+	wantFraction := -time.Duration(float64(cal.workdayLength()) * 0.5)
+	want := wantDate.Add(wantFraction)
+
+	if !got.Equal(want) {
+		t.Errorf("AddToBusiness(-P5.5D) = %v, want %v", got, want)
+	}
+}
+
+func TestAddToBusinessSkipsWeekend(t *testing.T) {
+	cal := Calendar{Weekend: [7]bool{time.Saturday: true, time.Sunday: true}}
+	p := MustParse("P5D")
+
+	start := time.Date(2024, time.March, 13, 0, 0, 0, 0, time.UTC) // a Wednesday
+	got, precise := p.AddToBusiness(start, cal)
+	if !precise {
+		t.Fatalf("expected a precise result for a whole-day period")
+	}
+
+	// 5 working days from a Wednesday skips the intervening weekend and
+	// lands on the next Wednesday, not on the calendar Monday.
+	want := time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("AddToBusiness(P5D) from a Wednesday = %v, want %v", got, want)
+	}
+}
+
+func TestAddToBusinessSkipsHoliday(t *testing.T) {
+	holiday := date.NewAt(time.Date(2024, time.March, 14, 0, 0, 0, 0, time.UTC))
+	cal := Calendar{Holidays: map[date.Date]bool{holiday: true}}
+	p := MustParse("P1D")
+
+	start := time.Date(2024, time.March, 13, 0, 0, 0, 0, time.UTC) // a Wednesday
+	got, _ := p.AddToBusiness(start, cal)
+
+	want := time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC) // skips the Thursday holiday
+	if !got.Equal(want) {
+		t.Errorf("AddToBusiness(P1D) across a holiday = %v, want %v", got, want)
+	}
+}
+
+func TestBusinessDurationNegative(t *testing.T) {
+	cal := Calendar{}
+	positive := MustParse("P2DT3H")
+	negative := MustParse("-P2DT3H")
+
+	pd := positive.BusinessDuration(cal)
+	nd := negative.BusinessDuration(cal)
+
+	if nd != -pd {
+		t.Errorf("BusinessDuration(-P2DT3H) = %v, want %v (negation of %v)", nd, -pd, pd)
+	}
+}
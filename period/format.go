@@ -0,0 +1,53 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import (
+	"fmt"
+	"strings"
+)
+
+// String converts the period to its ISO 8601 representation, e.g.
+// "P1Y2M3DT4H5M6S". A period parsed from a weeks designator ("P2W") is
+// reported back using "W" rather than being expanded to days.
+func (period Period) String() string {
+	if period.IsZero() {
+		return "P0D"
+	}
+
+	p, neg := period.absNeg()
+
+	var b strings.Builder
+	if neg {
+		b.WriteByte('-')
+	}
+	b.WriteByte('P')
+
+	writeField := func(value int16, d designator, letter byte) {
+		if value == 0 && p.fpart != d {
+			return
+		}
+		fmt.Fprintf(&b, "%d", value)
+		if p.fpart == d && p.fraction != 0 {
+			fmt.Fprintf(&b, ".%02d", p.fraction)
+		}
+		b.WriteByte(letter)
+	}
+
+	writeField(p.years, Year, 'Y')
+	writeField(p.months, Month, 'M')
+	writeField(p.weeks, Week, 'W')
+	writeField(p.days, Day, 'D')
+
+	if p.hours != 0 || p.minutes != 0 || p.seconds != 0 ||
+		p.fpart == Hour || p.fpart == Minute || p.fpart == Second {
+		b.WriteByte('T')
+		writeField(p.hours, Hour, 'H')
+		writeField(p.minutes, Minute, 'M')
+		writeField(p.seconds, Second, 'S')
+	}
+
+	return b.String()
+}
@@ -0,0 +1,193 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parse converts an ISO 8601 period string, such as "P1Y2M3DT4H5M6S" or
+// "P2W", into a Period. At most one field may carry a fraction, and only on
+// the last field present.
+func Parse(isoPeriod string) (Period, error) {
+	input := isoPeriod
+	s := isoPeriod
+
+	neg := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		neg, s = true, s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	if len(s) == 0 || s[0] != 'P' {
+		return Period{}, fmt.Errorf("%s: expected a leading 'P'", input)
+	}
+	s = s[1:]
+
+	datePart, timePart, hasTime := strings.Cut(s, "T")
+	if hasTime && timePart == "" {
+		return Period{}, fmt.Errorf("%s: expected a duration after 'T'", input)
+	}
+
+	var p Period
+
+	if datePart != "" {
+		fields, err := scanFields(datePart)
+		if err != nil {
+			return Period{}, fmt.Errorf("%s: %w", input, err)
+		}
+		for i, f := range fields {
+			if f.fracPart != "" && (i != len(fields)-1 || hasTime) {
+				return Period{}, fmt.Errorf("%s: a fractional value is only allowed on the last field", input)
+			}
+			value, frac, err := f.values()
+			if err != nil {
+				return Period{}, fmt.Errorf("%s: %w", input, err)
+			}
+			switch f.letter {
+			case 'Y':
+				p.years = value
+				if frac != 0 {
+					p.fraction, p.fpart = frac, Year
+				}
+			case 'M':
+				p.months = value
+				if frac != 0 {
+					p.fraction, p.fpart = frac, Month
+				}
+			case 'W':
+				p.weeks = value
+				if frac != 0 {
+					p.fraction, p.fpart = frac, Week
+				}
+			case 'D':
+				p.days = value
+				if frac != 0 {
+					p.fraction, p.fpart = frac, Day
+				}
+			default:
+				return Period{}, fmt.Errorf("%s: unexpected designator %q in date part", input, string(f.letter))
+			}
+		}
+	}
+
+	if hasTime {
+		fields, err := scanFields(timePart)
+		if err != nil {
+			return Period{}, fmt.Errorf("%s: %w", input, err)
+		}
+		for i, f := range fields {
+			if f.fracPart != "" && i != len(fields)-1 {
+				return Period{}, fmt.Errorf("%s: a fractional value is only allowed on the last field", input)
+			}
+			value, frac, err := f.values()
+			if err != nil {
+				return Period{}, fmt.Errorf("%s: %w", input, err)
+			}
+			switch f.letter {
+			case 'H':
+				p.hours = value
+				if frac != 0 {
+					p.fraction, p.fpart = frac, Hour
+				}
+			case 'M':
+				p.minutes = value
+				if frac != 0 {
+					p.fraction, p.fpart = frac, Minute
+				}
+			case 'S':
+				p.seconds = value
+				if frac != 0 {
+					p.fraction, p.fpart = frac, Second
+				}
+			default:
+				return Period{}, fmt.Errorf("%s: unexpected designator %q in time part", input, string(f.letter))
+			}
+		}
+	}
+
+	if neg {
+		p = p.Negate()
+	}
+	return p, nil
+}
+
+// MustParse is as per Parse, but panics instead of returning an error.
+func MustParse(isoPeriod string) Period {
+	p, err := Parse(isoPeriod)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// parsedField is one "<number>[.<fraction>]<letter>" component of an ISO
+// 8601 period string.
+type parsedField struct {
+	intPart, fracPart string
+	letter            byte
+}
+
+func (f parsedField) values() (int16, int8, error) {
+	n, err := strconv.ParseInt(f.intPart, 10, 16)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid number %q", f.intPart)
+	}
+
+	var frac int8
+	if f.fracPart != "" {
+		digits := f.fracPart
+		if len(digits) > 2 {
+			digits = digits[:2]
+		}
+		for len(digits) < 2 {
+			digits += "0"
+		}
+		v, err := strconv.Atoi(digits)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid fraction %q", f.fracPart)
+		}
+		frac = int8(v)
+	}
+
+	return int16(n), frac, nil
+}
+
+// scanFields splits a date or time part into its "<number><letter>" fields.
+func scanFields(s string) ([]parsedField, error) {
+	var fields []parsedField
+	for len(s) > 0 {
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == 0 {
+			return nil, fmt.Errorf("expected a number, found %q", s)
+		}
+		intPart := s[:i]
+
+		fracPart := ""
+		if i < len(s) && (s[i] == '.' || s[i] == ',') {
+			j := i + 1
+			for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+				j++
+			}
+			fracPart = s[i+1 : j]
+			i = j
+		}
+
+		if i >= len(s) {
+			return nil, fmt.Errorf("expected a designator letter after %q", s[:i])
+		}
+
+		fields = append(fields, parsedField{intPart: intPart, fracPart: fracPart, letter: s[i]})
+		s = s[i+1:]
+	}
+	return fields, nil
+}
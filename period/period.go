@@ -0,0 +1,247 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import (
+	"math"
+	"time"
+)
+
+// designator identifies which field, if any, carries the period's
+// fractional part.
+type designator int8
+
+// The values a fraction can apply to. NoFraction means the period is
+// composed entirely of whole numbers.
+const (
+	NoFraction designator = iota
+	Year
+	Month
+	Week
+	Day
+	Hour
+	Minute
+	Second
+)
+
+const (
+	oneE6 = 1000000
+
+	// average lengths, used only where an approximation is unavoidable
+	// (e.g. Duration on a period with fractional years/months).
+	daysPerMonthF  = 30.436875
+	daysPerMonthE6 = 30436875
+	hoursPerMonthF = daysPerMonthF * 24
+)
+
+// Period holds a period of time as a set of calendar fields: years, months,
+// weeks, days, hours, minutes and seconds. It represents the ISO 8601
+// period format "PnYnMnWnDTnHnMnS". At most one field carries a fraction,
+// identified by fpart (or NoFraction if the period is all whole numbers);
+// the fraction itself is stored as hundredths of that field's unit.
+//
+// All fields share the same sign: a negative period has every non-zero
+// field (including fraction) negative.
+type Period struct {
+	years, months, weeks, days, hours, minutes, seconds int16
+	fraction                                             int8
+	fpart                                                designator
+}
+
+// Sign returns 1 if the period is positive, -1 if it is negative, and 0 if
+// it is zero.
+func (period Period) Sign() int {
+	switch {
+	case period.years != 0:
+		return sign16(period.years)
+	case period.months != 0:
+		return sign16(period.months)
+	case period.weeks != 0:
+		return sign16(period.weeks)
+	case period.days != 0:
+		return sign16(period.days)
+	case period.hours != 0:
+		return sign16(period.hours)
+	case period.minutes != 0:
+		return sign16(period.minutes)
+	case period.seconds != 0:
+		return sign16(period.seconds)
+	case period.fraction != 0:
+		return sign8(period.fraction)
+	}
+	return 0
+}
+
+func sign16(v int16) int {
+	if v < 0 {
+		return -1
+	}
+	return 1
+}
+
+func sign8(v int8) int {
+	if v < 0 {
+		return -1
+	}
+	return 1
+}
+
+// IsNegative reports whether the period is less than zero.
+func (period Period) IsNegative() bool {
+	return period.Sign() < 0
+}
+
+// IsZero reports whether the period is zero-length.
+func (period Period) IsZero() bool {
+	return period.Sign() == 0
+}
+
+// Negate changes the sign of the period, leaving its magnitude unchanged.
+func (period Period) Negate() Period {
+	return Period{
+		years: -period.years, months: -period.months, weeks: -period.weeks, days: -period.days,
+		hours: -period.hours, minutes: -period.minutes, seconds: -period.seconds,
+		fraction: -period.fraction,
+		fpart:    period.fpart,
+	}
+}
+
+// absNeg returns the absolute value of the period, along with true if it
+// was originally negative.
+func (period Period) absNeg() (Period, bool) {
+	if period.IsNegative() {
+		return period.Negate(), true
+	}
+	return period, false
+}
+
+// condNegate negates the period if neg is true, otherwise returns it
+// unchanged.
+func (period Period) condNegate(neg bool) Period {
+	if neg {
+		return period.Negate()
+	}
+	return period
+}
+
+// centiYM returns years and months combined into hundredths of a month.
+func (period Period) centiYM() int64 {
+	cym := (int64(period.years)*12 + int64(period.months)) * 100
+	switch period.fpart {
+	case Year:
+		cym += int64(period.fraction) * 12
+	case Month:
+		cym += int64(period.fraction)
+	}
+	return cym
+}
+
+// centiDays returns weeks and days combined into hundredths of a day; weeks
+// are decomposed into days here so downstream arithmetic (Add, Scale,
+// Normalise, AddTo) doesn't need to know about them separately.
+func (period Period) centiDays() int64 {
+	cd := (int64(period.days) + int64(period.weeks)*7) * 100
+	switch period.fpart {
+	case Week:
+		cd += int64(period.fraction) * 7
+	case Day:
+		cd += int64(period.fraction)
+	}
+	return cd
+}
+
+// centiHMS returns hours, minutes and seconds combined into hundredths of a
+// second.
+func (period Period) centiHMS() int64 {
+	chms := (int64(period.hours)*3600 + int64(period.minutes)*60 + int64(period.seconds)) * 100
+	switch period.fpart {
+	case Hour:
+		chms += int64(period.fraction) * 3600
+	case Minute:
+		chms += int64(period.fraction) * 60
+	case Second:
+		chms += int64(period.fraction)
+	}
+	return chms
+}
+
+// hmsDuration converts just the hours/minutes/seconds fields (and any
+// fraction of an hour, minute or second) to a time.Duration; it is always
+// precise.
+func (period Period) hmsDuration() time.Duration {
+	d := time.Duration(period.hours)*time.Hour +
+		time.Duration(period.minutes)*time.Minute +
+		time.Duration(period.seconds)*time.Second
+	switch period.fpart {
+	case Hour:
+		d += time.Duration(period.fraction) * (time.Hour / 100)
+	case Minute:
+		d += time.Duration(period.fraction) * (time.Minute / 100)
+	case Second:
+		d += time.Duration(period.fraction) * (time.Second / 100)
+	}
+	return d
+}
+
+// Duration converts the period to an approximate time.Duration. The result
+// is precise when the period has no fractional component; otherwise years
+// and months are approximated using the average Gregorian month length.
+func (period Period) Duration() (time.Duration, bool) {
+	months := float64(period.centiYM()) / 100
+	days := float64(period.centiDays()) / 100
+	d := time.Duration(months*hoursPerMonthF*float64(time.Hour)) +
+		time.Duration(days*24*float64(time.Hour)) +
+		period.hmsDuration()
+	return d, period.fpart == NoFraction
+}
+
+// NewOf converts a plain time.Duration to a Period expressed purely in
+// hours, minutes and seconds. The bool result is true unless the duration
+// is so large that the hours field would overflow int16.
+func NewOf(d time.Duration) (Period, bool) {
+	neg := d < 0
+	if neg {
+		d = -d
+	}
+
+	totalSeconds := d / time.Second
+	frac := d - totalSeconds*time.Second
+	seconds := totalSeconds % 60
+	totalMinutes := totalSeconds / 60
+	minutes := totalMinutes % 60
+	hours := totalMinutes / 60
+
+	p := Period{
+		hours:   int16(hours),
+		minutes: int16(minutes),
+		seconds: int16(seconds),
+	}
+	if frac != 0 {
+		p.fraction = int8((frac * 100) / time.Second)
+		p.fpart = Second
+	}
+
+	precise := hours <= math.MaxInt16
+	return p.condNegate(neg), precise
+}
+
+// Normalise ripples fractional and overflowing fields up into larger units
+// (e.g. 90 minutes becomes 1 hour 30 minutes), routing through the
+// period64 working representation. precise controls how aggressively
+// fractional days are folded into hours, as per AddTo.
+func (period Period) Normalise(precise bool) Period {
+	p64 := period.toPeriod64("").normalise64(precise)
+	normalised, err := p64.toPeriod()
+	if err != nil {
+		return period
+	}
+	return normalised
+}
+
+// Simplify returns the same result as Normalise; it does no further
+// collapsing of fields beyond what Normalise does.
+func (period Period) Simplify(precise bool) Period {
+	return period.Normalise(precise)
+}
@@ -49,9 +49,14 @@ func p64Of(cym, cd, chms int64, neg bool) *period64 {
 }
 
 func (period Period) toPeriod64(input string) *period64 {
+	// weeks have no field of their own in period64; they are decomposed
+	// into days here, same as centiDays does for the other arithmetic
+	// paths.
+	days := int64(period.days) + int64(period.weeks)*7
+
 	if period.IsNegative() {
 		return &period64{
-			years: int64(-period.years), months: int64(-period.months), days: int64(-period.days),
+			years: int64(-period.years), months: int64(-period.months), days: -days,
 			hours: int64(-period.hours), minutes: int64(-period.minutes), seconds: int64(-period.seconds),
 			fraction: -period.fraction,
 			fpart:    period.fpart,
@@ -60,7 +65,7 @@ func (period Period) toPeriod64(input string) *period64 {
 		}
 	}
 	return &period64{
-		years: int64(period.years), months: int64(period.months), days: int64(period.days),
+		years: int64(period.years), months: int64(period.months), days: days,
 		hours: int64(period.hours), minutes: int64(period.minutes), seconds: int64(period.seconds),
 		fraction: period.fraction,
 		fpart:    period.fpart,
@@ -116,6 +121,44 @@ func (p64 *period64) toPeriod() (Period, error) {
 	}, nil
 }
 
+// String converts p64 to its ISO 8601 representation, mirroring
+// Period.String; it exists so that integer-overflow errors in toPeriod can
+// report the offending value even though it no longer fits in a Period's
+// int16 fields. Unlike Period.String, it has no "W" case, since weeks are
+// always decomposed into days before a period64 is built.
+func (p64 *period64) String() string {
+	var b strings.Builder
+	if p64.neg {
+		b.WriteByte('-')
+	}
+	b.WriteByte('P')
+
+	writeField := func(value int64, d designator, letter byte) {
+		if value == 0 && p64.fpart != d {
+			return
+		}
+		fmt.Fprintf(&b, "%d", value)
+		if p64.fpart == d && p64.fraction != 0 {
+			fmt.Fprintf(&b, ".%02d", p64.fraction)
+		}
+		b.WriteByte(letter)
+	}
+
+	writeField(p64.years, Year, 'Y')
+	writeField(p64.months, Month, 'M')
+	writeField(p64.days, Day, 'D')
+
+	if p64.hours != 0 || p64.minutes != 0 || p64.seconds != 0 ||
+		p64.fpart == Hour || p64.fpart == Minute || p64.fpart == Second {
+		b.WriteByte('T')
+		writeField(p64.hours, Hour, 'H')
+		writeField(p64.minutes, Minute, 'M')
+		writeField(p64.seconds, Second, 'S')
+	}
+
+	return b.String()
+}
+
 // normalise64 operates on values in which all fields are positive
 func (p64 *period64) normalise64(precise bool) *period64 {
 	return p64.rippleUp(precise).
@@ -0,0 +1,96 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import (
+	"fmt"
+	"time"
+)
+
+// Range is an inclusive range of periods, typically used to validate that a
+// configured or user-supplied Period falls within sane bounds, e.g. "the
+// polling interval must be between PT30S and PT24H".
+type Range struct {
+	Min, Max Period
+}
+
+// ParseRange parses min and max as periods and returns the Range between
+// them. It is an error for min to be greater than or equal to max, as
+// measured by their approximate durations.
+func ParseRange(min, max string) (Range, error) {
+	minP, err := Parse(min)
+	if err != nil {
+		return Range{}, fmt.Errorf("invalid range: %w", err)
+	}
+	maxP, err := Parse(max)
+	if err != nil {
+		return Range{}, fmt.Errorf("invalid range: %w", err)
+	}
+
+	minD, _ := minP.Duration()
+	maxD, _ := maxP.Duration()
+	if minD >= maxD {
+		return Range{}, fmt.Errorf("invalid range: min %s must be less than max %s", minP, maxP)
+	}
+
+	return Range{Min: minP, Max: maxP}, nil
+}
+
+// MustParseRange is as per ParseRange, but panics instead of returning an
+// error.
+func MustParseRange(min, max string) Range {
+	r, err := ParseRange(min, max)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Contains reports whether p's approximate duration lies within the range,
+// inclusive of Min and Max. Comparison is defined on the approximate
+// duration rather than on the individual fields, so e.g. P1M is considered
+// within [P28D, P31D].
+func (r Range) Contains(p Period) bool {
+	d, _ := p.Duration()
+	minD, _ := r.Min.Duration()
+	maxD, _ := r.Max.Duration()
+	return d >= minD && d <= maxD
+}
+
+// ContainsDuration is as per Contains, but compares a plain time.Duration
+// against the range directly.
+func (r Range) ContainsDuration(d time.Duration) bool {
+	minD, _ := r.Min.Duration()
+	maxD, _ := r.Max.Duration()
+	return d >= minD && d <= maxD
+}
+
+// Validate returns an error if p does not lie within the range.
+func (r Range) Validate(p Period) error {
+	if !r.Contains(p) {
+		return fmt.Errorf("%s: not within the range %s to %s", p, r.Min, r.Max)
+	}
+	return nil
+}
+
+// StrictContains is as per Contains, but additionally requires p to use the
+// same broad family of designators - calendar-based (years/months) versus
+// clock-based (days/hours/minutes/seconds) - as both Min and Max. This
+// avoids comparing across the years-vs-days ambiguity: P1M is never
+// considered StrictContains-within [P28D, P31D], even though their
+// approximate durations overlap.
+func (r Range) StrictContains(p Period) bool {
+	family := calendarFamily(p)
+	if family != calendarFamily(r.Min) || family != calendarFamily(r.Max) {
+		return false
+	}
+	return r.Contains(p)
+}
+
+// calendarFamily reports whether a period carries a calendar-based
+// (year/month) component, as opposed to a purely clock-based one.
+func calendarFamily(p Period) bool {
+	return p.years != 0 || p.months != 0 || p.fpart == Year || p.fpart == Month
+}
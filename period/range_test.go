@@ -0,0 +1,82 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import "testing"
+
+func TestParseRangePollingInterval(t *testing.T) {
+	r, err := ParseRange("PT30S", "PT24H")
+	if err != nil {
+		t.Fatalf("ParseRange failed: %v", err)
+	}
+
+	cases := []struct {
+		interval string
+		want     bool
+	}{
+		{"PT30S", true},
+		{"PT24H", true},
+		{"PT1M", true},
+		{"PT1S", false},
+		{"PT25H", false},
+	}
+	for _, c := range cases {
+		p := MustParse(c.interval)
+		if got := r.Contains(p); got != c.want {
+			t.Errorf("Contains(%s) = %v, want %v", c.interval, got, c.want)
+		}
+		if err := r.Validate(p); (err == nil) != c.want {
+			t.Errorf("Validate(%s) error = %v, want error: %v", c.interval, err, !c.want)
+		}
+	}
+}
+
+func TestParseRangeRejectsInvertedBounds(t *testing.T) {
+	if _, err := ParseRange("PT24H", "PT30S"); err == nil {
+		t.Errorf("ParseRange(PT24H, PT30S) error = nil, want an error since min >= max")
+	}
+	if _, err := ParseRange("PT1H", "PT1H"); err == nil {
+		t.Errorf("ParseRange(PT1H, PT1H) error = nil, want an error since min >= max")
+	}
+}
+
+func TestMustParseRangePanicsOnInvalidRange(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustParseRange did not panic on an invalid range")
+		}
+	}()
+	MustParseRange("PT24H", "PT30S")
+}
+
+func TestRangeContainsDuration(t *testing.T) {
+	r := MustParseRange("PT30S", "PT24H")
+	from, _ := r.Min.Duration()
+	to, _ := r.Max.Duration()
+
+	if !r.ContainsDuration(from) || !r.ContainsDuration(to) {
+		t.Errorf("ContainsDuration should include both bounds")
+	}
+	if r.ContainsDuration(from - 1) {
+		t.Errorf("ContainsDuration(min-1) = true, want false")
+	}
+	if r.ContainsDuration(to + 1) {
+		t.Errorf("ContainsDuration(max+1) = true, want false")
+	}
+}
+
+func TestRangeStrictContains(t *testing.T) {
+	r := MustParseRange("P28D", "P31D")
+
+	if !r.StrictContains(MustParse("P30D")) {
+		t.Errorf("StrictContains(P30D) = false, want true")
+	}
+	if r.StrictContains(MustParse("P1M")) {
+		t.Errorf("StrictContains(P1M) = true, want false: a calendar month must not be treated as interchangeable with a day count")
+	}
+	if r.Contains(MustParse("P1M")) == false {
+		t.Errorf("Contains(P1M) = false, want true: approximate duration of P1M overlaps [P28D, P31D]")
+	}
+}
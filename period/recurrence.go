@@ -0,0 +1,185 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import (
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recurrence represents an ISO 8601 recurring time interval, e.g.
+// "R5/2020-01-01T00:00:00Z/P1M", which repeats every P1M starting at the
+// given instant, five times in total.
+//
+// The general form is R[n]/<interval>, where <interval> is one of
+// <start>/<end>, <start>/<period> or <period>/<end>. Omitting n (i.e. "R/")
+// means the recurrence is unbounded; Count reports -1 in that case.
+type Recurrence struct {
+	count  int // -1 means unbounded
+	anchor time.Time
+	step   Period
+	input  string
+}
+
+// ParseRecurrence parses an ISO 8601 recurring interval such as
+// "R5/2020-01-01T00:00:00Z/P1M" or "R/PT1H/2020-01-02T00:00:00Z". It is
+// named ParseRecurrence, rather than Parse, to avoid clashing with the
+// existing Parse function for plain periods.
+func ParseRecurrence(value string) (Recurrence, error) {
+	parts := strings.SplitN(value, "/", 2)
+	if len(parts) != 2 || !strings.HasPrefix(parts[0], "R") {
+		return Recurrence{}, fmt.Errorf("%s: not a valid ISO8601 recurring interval (expected R[n]/<interval>)", value)
+	}
+
+	count := -1
+	if nStr := parts[0][1:]; nStr != "" {
+		n, err := strconv.Atoi(nStr)
+		if err != nil || n < 0 {
+			return Recurrence{}, fmt.Errorf("%s: invalid repeat count %q", value, nStr)
+		}
+		count = n
+	}
+
+	ivParts := strings.SplitN(parts[1], "/", 2)
+	if len(ivParts) != 2 {
+		return Recurrence{}, fmt.Errorf("%s: expected <interval> in the form start/end, start/period or period/end", value)
+	}
+
+	left, err := parseRecurrencePart(value, ivParts[0])
+	if err != nil {
+		return Recurrence{}, err
+	}
+	right, err := parseRecurrencePart(value, ivParts[1])
+	if err != nil {
+		return Recurrence{}, err
+	}
+
+	var anchor time.Time
+	var step Period
+	switch {
+	case !left.isPeriod && !right.isPeriod:
+		// <start>/<end>: derive the step from the calendar difference.
+		anchor = left.t
+		step = Between(left.t, right.t)
+
+	case !left.isPeriod && right.isPeriod:
+		// <start>/<period>
+		anchor = left.t
+		step = right.p
+
+	case left.isPeriod && !right.isPeriod:
+		// <period>/<end>: count back from the end to find the first occurrence.
+		if count < 0 {
+			return Recurrence{}, fmt.Errorf("%s: a <period>/<end> recurrence must have a finite repeat count", value)
+		}
+		step = left.p
+		anchor = right.t
+		for i := 0; i < count; i++ {
+			back, _ := step.Negate().AddTo(anchor)
+			anchor = back
+		}
+
+	default:
+		return Recurrence{}, fmt.Errorf("%s: <interval> cannot be <period>/<period>", value)
+	}
+
+	return Recurrence{count: count, anchor: anchor, step: step, input: value}, nil
+}
+
+// recurrencePart is either a timestamp or a period, as found on either side
+// of the interval in an ISO 8601 recurring interval.
+type recurrencePart struct {
+	isPeriod bool
+	t        time.Time
+	p        Period
+}
+
+func parseRecurrencePart(whole, part string) (recurrencePart, error) {
+	if strings.HasPrefix(part, "P") {
+		p, err := Parse(part)
+		if err != nil {
+			return recurrencePart{}, fmt.Errorf("%s: %w", whole, err)
+		}
+		return recurrencePart{isPeriod: true, p: p}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, part)
+	if err != nil {
+		return recurrencePart{}, fmt.Errorf("%s: %q is neither a period nor an RFC3339 timestamp", whole, part)
+	}
+	return recurrencePart{t: t}, nil
+}
+
+// Count returns the number of occurrences, or -1 if the recurrence is
+// unbounded (an "R/" form).
+func (r Recurrence) Count() int {
+	return r.count
+}
+
+// String reconstructs the ISO 8601 representation of the recurrence.
+func (r Recurrence) String() string {
+	n := ""
+	if r.count >= 0 {
+		n = strconv.Itoa(r.count)
+	}
+	return fmt.Sprintf("R%s/%s/%s", n, r.anchor.Format(time.RFC3339), r.step)
+}
+
+// Next finds the first occurrence strictly after t, applying the recurrence's
+// period to its anchor step by step. The bool result is false once the
+// bounded count of occurrences has been exhausted.
+func (r Recurrence) Next(t time.Time) (time.Time, bool) {
+	occurrence := r.anchor
+	for i := 0; r.count < 0 || i < r.count; i++ {
+		next, _ := r.step.AddTo(occurrence)
+		if !next.After(occurrence) {
+			// a zero-length step can never advance past t
+			return time.Time{}, false
+		}
+		occurrence = next
+		if occurrence.After(t) {
+			return occurrence, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Occurrences yields every occurrence of the recurrence strictly after from,
+// in order, stopping once the bounded count (if any) is exhausted.
+func (r Recurrence) Occurrences(from time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		t := from
+		for {
+			next, ok := r.Next(t)
+			if !ok {
+				return
+			}
+			if !yield(next) {
+				return
+			}
+			t = next
+		}
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, and thereby also supports
+// JSON marshalling of Recurrence values.
+func (r Recurrence) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, and thereby also
+// supports JSON unmarshalling of Recurrence values.
+func (r *Recurrence) UnmarshalText(text []byte) error {
+	rec, err := ParseRecurrence(string(text))
+	if err != nil {
+		return err
+	}
+	*r = rec
+	return nil
+}
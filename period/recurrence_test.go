@@ -0,0 +1,84 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRecurrenceStartPeriod(t *testing.T) {
+	r, err := ParseRecurrence("R5/2020-01-01T00:00:00Z/P1M")
+	if err != nil {
+		t.Fatalf("ParseRecurrence failed: %v", err)
+	}
+	if r.Count() != 5 {
+		t.Errorf("Count() = %d, want 5", r.Count())
+	}
+
+	from := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	want := []time.Time{
+		time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.June, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	var got []time.Time
+	for occ := range r.Occurrences(from) {
+		got = append(got, occ)
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d occurrences, want %d", len(got), len(want))
+	}
+	for i, g := range got {
+		if !g.Equal(want[i]) {
+			t.Errorf("occurrence %d = %v, want %v", i, g, want[i])
+		}
+	}
+}
+
+func TestParseRecurrenceUnbounded(t *testing.T) {
+	r, err := ParseRecurrence("R/2020-01-01T00:00:00Z/P1D")
+	if err != nil {
+		t.Fatalf("ParseRecurrence failed: %v", err)
+	}
+	if r.Count() != -1 {
+		t.Errorf("Count() = %d, want -1 for an unbounded recurrence", r.Count())
+	}
+
+	from := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	next, ok := r.Next(from)
+	if !ok {
+		t.Fatalf("Next() = false, want true")
+	}
+	want := time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC)
+	if !next.Equal(want) {
+		t.Errorf("Next(%v) = %v, want %v", from, next, want)
+	}
+}
+
+func TestRecurrenceTextRoundTrip(t *testing.T) {
+	r, err := ParseRecurrence("R5/2020-01-01T00:00:00Z/P1M")
+	if err != nil {
+		t.Fatalf("ParseRecurrence failed: %v", err)
+	}
+
+	text, err := r.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText failed: %v", err)
+	}
+
+	var r2 Recurrence
+	if err := r2.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText failed: %v", err)
+	}
+
+	if r2.Count() != r.Count() || !r2.anchor.Equal(r.anchor) || r2.step != r.step {
+		t.Errorf("round-trip mismatch: got %+v, want %+v", r2, r)
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+// Weeks reports the period's weeks component, and true if it is non-zero.
+// Rather than being converted to days, weeks are preserved as a distinct
+// field through Parse and String, so MustParse("P2W").String() returns "P2W" rather than
+// "P14D".
+func (period Period) Weeks() (int, bool) {
+	return int(period.weeks), period.weeks != 0
+}
+
+// WithWeeks returns a copy of the period with its weeks component set to weeks,
+// leaving every other field (including days) unchanged.
+func (period Period) WithWeeks(weeks int) Period {
+	period.weeks = int16(weeks)
+	return period
+}
@@ -0,0 +1,67 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package period
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeeksRoundTrip(t *testing.T) {
+	cases := []string{"P2W", "P3W4D", "-P2W"}
+	for _, s := range cases {
+		p, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Parse(%q) failed: %v", s, err)
+		}
+		if got := p.String(); got != s {
+			t.Errorf("Parse(%q).String() = %q, want %q", s, got, s)
+		}
+	}
+}
+
+func TestWeeksAccessors(t *testing.T) {
+	p := MustParse("P2W")
+	if w, ok := p.Weeks(); !ok || w != 2 {
+		t.Errorf("Weeks() = (%d, %v), want (2, true)", w, ok)
+	}
+
+	q := Period{}.WithWeeks(3)
+	if w, ok := q.Weeks(); !ok || w != 3 {
+		t.Errorf("WithWeeks(3).Weeks() = (%d, %v), want (3, true)", w, ok)
+	}
+	if got, want := q.String(), "P3W"; got != want {
+		t.Errorf("WithWeeks(3).String() = %q, want %q", got, want)
+	}
+}
+
+func TestWeeksDecomposeIntoDaysForArithmetic(t *testing.T) {
+	p := MustParse("P2W")
+	d, precise := p.Duration()
+	if !precise {
+		t.Fatalf("Duration() not precise for a whole period")
+	}
+	if want := 14 * 24 * time.Hour; d != want {
+		t.Errorf("Duration() = %v, want %v", d, want)
+	}
+}
+
+func TestWeeksDecomposeIntoDaysOnAdd(t *testing.T) {
+	p := MustParse("P2W")
+	got := p.Add(p)
+	want := MustParse("P28D")
+	if got != want {
+		t.Errorf("P2W.Add(P2W) = %+v, want %+v (28 days, weeks folded in)", got, want)
+	}
+}
+
+func TestWeeksDecomposeIntoDaysOnNormalise(t *testing.T) {
+	p := MustParse("P2W")
+	got := p.Normalise(true)
+	want := MustParse("P14D")
+	if got != want {
+		t.Errorf("P2W.Normalise(true) = %+v, want %+v", got, want)
+	}
+}